@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// maxDocumentBatchBytes is CloudSearch's hard limit on a single
+// UploadDocuments batch. See:
+// https://docs.aws.amazon.com/cloudsearch/latest/developerguide/preparing-data.html
+const maxDocumentBatchBytes = 5 * 1024 * 1024
+
+// DocumentOp is a single add or delete operation destined for a CloudSearch
+// domain's document endpoint, analogous to one action in an Elasticsearch
+// Bulk API request body.
+type DocumentOp struct {
+	ID      string
+	Type    string // "add" or "delete"
+	Fields  map[string]interface{}
+	Version *int64
+}
+
+// sdfDocument is the on-the-wire Search Data Format representation of a
+// DocumentOp.
+type sdfDocument struct {
+	Type    string                 `json:"type"`
+	ID      string                 `json:"id"`
+	Version *int64                 `json:"version,omitempty"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+}
+
+func (op DocumentOp) toSDF() sdfDocument {
+	return sdfDocument{
+		Type:    op.Type,
+		ID:      op.ID,
+		Version: op.Version,
+		Fields:  op.Fields,
+	}
+}
+
+// SplitDocumentBatches groups docs into SDF batches, each marshaled to JSON
+// and kept under maxDocumentBatchBytes. A single document whose own encoding
+// already exceeds the limit cannot be split further and is returned as an
+// error rather than silently dropped or truncated.
+func SplitDocumentBatches(docs []DocumentOp) ([][]byte, error) {
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	var batches [][]byte
+	var current []sdfDocument
+	currentSize := len(`[]`)
+
+	flush := func() error {
+		if len(current) == 0 {
+			return nil
+		}
+		b, err := json.Marshal(current)
+		if err != nil {
+			return fmt.Errorf("marshaling document batch: %w", err)
+		}
+		batches = append(batches, b)
+		current = nil
+		currentSize = len(`[]`)
+		return nil
+	}
+
+	for _, doc := range docs {
+		sdf := doc.toSDF()
+		encoded, err := json.Marshal(sdf)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling document %q: %w", doc.ID, err)
+		}
+
+		// +1 accounts for the comma joining this element to its neighbor.
+		docSize := len(encoded) + 1
+		if len(encoded)+len(`[]`) > maxDocumentBatchBytes {
+			return nil, fmt.Errorf("document %q is %d bytes, too large to fit in a %d byte CloudSearch batch on its own", doc.ID, len(encoded), maxDocumentBatchBytes)
+		}
+
+		if currentSize+docSize > maxDocumentBatchBytes {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+
+		current = append(current, sdf)
+		currentSize += docSize
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
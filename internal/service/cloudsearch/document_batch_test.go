@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSplitDocumentBatches(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		input       []DocumentOp
+		wantBatches int
+		wantErr     bool
+	}{
+		{
+			name:        "empty batch",
+			input:       nil,
+			wantBatches: 0,
+			wantErr:     false,
+		},
+		{
+			name: "oversize single doc",
+			input: []DocumentOp{
+				{
+					ID:   "huge",
+					Type: "add",
+					Fields: map[string]interface{}{
+						"body": strings.Repeat("x", maxDocumentBatchBytes+1),
+					},
+				},
+			},
+			wantBatches: 0,
+			wantErr:     true,
+		},
+		{
+			name: "mixed adds and deletes fit in one batch",
+			input: []DocumentOp{
+				{ID: "1", Type: "add", Fields: map[string]interface{}{"title": "one"}},
+				{ID: "2", Type: "delete"},
+				{ID: "3", Type: "add", Fields: map[string]interface{}{"title": "three"}},
+			},
+			wantBatches: 1,
+			wantErr:     false,
+		},
+		{
+			name: "oversize aggregate splits into multiple batches",
+			input: func() []DocumentOp {
+				var docs []DocumentOp
+				// Each doc is ~1MB; six of them won't fit in one 5MB batch.
+				for i := 0; i < 6; i++ {
+					docs = append(docs, DocumentOp{
+						ID:   fmt.Sprintf("doc-%d", i),
+						Type: "add",
+						Fields: map[string]interface{}{
+							"body": strings.Repeat("x", 1024*1024),
+						},
+					})
+				}
+				return docs
+			}(),
+			wantBatches: 2,
+			wantErr:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			batches, err := SplitDocumentBatches(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(batches) != tc.wantBatches {
+				t.Errorf("expected %d batches, got %d", tc.wantBatches, len(batches))
+			}
+
+			for _, b := range batches {
+				if len(b) > maxDocumentBatchBytes {
+					t.Errorf("batch of %d bytes exceeds max of %d", len(b), maxDocumentBatchBytes)
+				}
+			}
+		})
+	}
+}
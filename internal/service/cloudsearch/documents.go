@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearchdomain"
+	cloudsearchdomaintypes "github.com/aws/aws-sdk-go-v2/service/cloudsearchdomain/types"
+	"github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// uploadRetryTimeout bounds the exponential backoff retry of a single
+// batch's UploadDocuments call on 5xx errors.
+const uploadRetryTimeout = 5 * time.Minute
+
+// @SDKResource("aws_cloudsearch_documents", name="Documents")
+func ResourceDocuments() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDocumentsUpload,
+		ReadWithoutTimeout:   resourceDocumentsRead,
+		UpdateWithoutTimeout: resourceDocumentsUpload,
+		DeleteWithoutTimeout: resourceDocumentsDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"fields": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"uploaded_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_content", "document"},
+			},
+			"source_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_file", "document"},
+			},
+			"adds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"deletes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"warnings": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDocumentsUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	domainName := d.Get(names.AttrDomainName).(string)
+
+	batches, addedIDs, err := buildDocumentBatches(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building CloudSearch Domain (%s) document batches: %s", domainName, err)
+	}
+
+	conn, err := domainDocumentServiceConn(ctx, meta, domainName)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "configuring CloudSearch Domain (%s) document service endpoint: %s", domainName, err)
+	}
+
+	var adds, deletes, warnings, errs int
+	for _, batch := range batches {
+		out, err := uploadDocumentBatchWithRetry(ctx, conn, batch)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading CloudSearch Domain (%s) documents: %s", domainName, err)
+		}
+		adds += int(aws.ToInt64(out.Adds))
+		deletes += int(aws.ToInt64(out.Deletes))
+		warnings += len(out.Warnings)
+		if aws.ToString(out.Status) == "error" {
+			errs++
+		}
+	}
+
+	d.SetId(domainName)
+	d.Set("adds", adds)
+	d.Set("deletes", deletes)
+	d.Set("warnings", warnings)
+	d.Set("errors", errs)
+	d.Set("uploaded_ids", addedIDs)
+
+	return diags
+}
+
+func resourceDocumentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// CloudSearch's document endpoint has no "describe what's indexed"
+	// operation; the counts recorded at upload time are the only record we
+	// have, so Read is a no-op that trusts state.
+	return nil
+}
+
+func resourceDocumentsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	domainName := d.Get(names.AttrDomainName).(string)
+
+	// CloudSearch has no delete-all API, so Delete replays as a delete every
+	// add ID that the most recent upload recorded in state. Documents uploaded
+	// from source_file or source_content are not recorded there, so Delete
+	// leaves them in place.
+	ids, _ := d.Get("uploaded_ids").([]interface{})
+	if len(ids) == 0 {
+		return diags
+	}
+
+	var docs []DocumentOp
+	for _, id := range ids {
+		docs = append(docs, DocumentOp{ID: id.(string), Type: "delete"})
+	}
+
+	batches, err := SplitDocumentBatches(docs)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building CloudSearch Domain (%s) delete batches: %s", domainName, err)
+	}
+
+	conn, err := domainDocumentServiceConn(ctx, meta, domainName)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "configuring CloudSearch Domain (%s) document service endpoint: %s", domainName, err)
+	}
+
+	for _, batch := range batches {
+		if _, err := uploadDocumentBatchWithRetry(ctx, conn, batch); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting CloudSearch Domain (%s) documents: %s", domainName, err)
+		}
+	}
+
+	return diags
+}
+
+// buildDocumentBatches assembles the configured document blocks into SDF
+// batches under CloudSearch's 5 MB limit, and returns the IDs of every "add"
+// so Delete can replay them later. When source_file or source_content is set,
+// its content is returned as-is as a single batch: it is not split to fit the
+// 5 MB limit, and no IDs are returned for it.
+func buildDocumentBatches(d *schema.ResourceData) ([][]byte, []string, error) {
+	if v, ok := d.GetOk("source_file"); ok {
+		content, err := os.ReadFile(v.(string))
+		if err != nil {
+			return nil, nil, err
+		}
+		return [][]byte{content}, nil, nil
+	}
+	if v, ok := d.GetOk("source_content"); ok {
+		return [][]byte{[]byte(v.(string))}, nil, nil
+	}
+
+	var docs []DocumentOp
+	var addedIDs []string
+	for _, v := range d.Get("document").([]interface{}) {
+		tfMap := v.(map[string]interface{})
+
+		var version *int64
+		if raw, ok := tfMap["version"].(int); ok && raw > 0 {
+			version = aws.Int64(int64(raw))
+		}
+
+		fields := make(map[string]interface{})
+		for k, v := range tfMap["fields"].(map[string]interface{}) {
+			fields[k] = v
+		}
+
+		op := DocumentOp{
+			ID:      tfMap[names.AttrID].(string),
+			Type:    tfMap[names.AttrType].(string),
+			Fields:  fields,
+			Version: version,
+		}
+		docs = append(docs, op)
+		if op.Type == "add" {
+			addedIDs = append(addedIDs, op.ID)
+		}
+	}
+
+	batches, err := SplitDocumentBatches(docs)
+	return batches, addedIDs, err
+}
+
+// domainDocumentServiceConn looks up the domain's document service endpoint
+// and returns a cloudsearchdomain client pointed at it. Unlike most AWS
+// services, CloudSearch's document/search APIs are served per-domain, so
+// the client can't be built once at provider configuration time.
+func domainDocumentServiceConn(ctx context.Context, meta interface{}, domainName string) (*cloudsearchdomain.Client, error) {
+	client := meta.(*conns.AWSClient)
+	searchConn := client.CloudSearchClient(ctx)
+
+	out, err := searchConn.DescribeDomains(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range out.DomainStatusList {
+		if aws.ToString(status.DomainName) == domainName {
+			endpoint := aws.ToString(status.DocService.Endpoint)
+			return cloudsearchdomain.NewFromConfig(client.AwsConfig(ctx), func(o *cloudsearchdomain.Options) {
+				o.BaseEndpoint = aws.String("https://" + endpoint)
+			}), nil
+		}
+	}
+
+	return nil, fmt.Errorf("CloudSearch Domain (%s) not found", domainName)
+}
+
+// uploadDocumentBatchWithRetry retries a single batch upload with
+// exponential backoff on 5xx errors, matching how the rest of this provider
+// handles eventually-consistent AWS APIs.
+func uploadDocumentBatchWithRetry(ctx context.Context, conn *cloudsearchdomain.Client, batch []byte) (*cloudsearchdomain.UploadDocumentsOutput, error) {
+	var out *cloudsearchdomain.UploadDocumentsOutput
+
+	err := retry.RetryContext(ctx, uploadRetryTimeout, func() *retry.RetryError {
+		var err error
+		out, err = conn.UploadDocuments(ctx, &cloudsearchdomain.UploadDocumentsInput{
+			ContentType: cloudsearchdomaintypes.ContentTypeApplicationJson,
+			Documents:   bytes.NewReader(batch),
+		})
+		if err != nil {
+			if isRetryableUploadError(err) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	return out, err
+}
+
+// isRetryableUploadError reports whether err is a 5xx response from the
+// document service. Anything else (4xx validation errors from a malformed
+// SDF batch, network errors without a status code, etc.) is treated as
+// permanent so bad input fails fast instead of burning uploadRetryTimeout.
+func isRetryableUploadError(err error) bool {
+	var responseErr *http.ResponseError
+	if !errors.As(err, &responseErr) {
+		return false
+	}
+	return responseErr.HTTPStatusCode() >= 500
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestIsRetryableUploadError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "5xx is retryable",
+			err: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 503}},
+			},
+			expected: true,
+		},
+		{
+			name: "4xx is not retryable",
+			err: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 400}},
+			},
+			expected: false,
+		},
+		{
+			name:     "non-response error is not retryable",
+			err:      errors.New("boom"),
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isRetryableUploadError(tc.err); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudsearch_domain", name="Domain")
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		CustomizeDiff: resourceDomainCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"index_field": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_fields": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"analysis_scheme": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"facet_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"return_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"search_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sort_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"highlight_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrEndpoint: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_index_documents": autoIndexDocumentsSchema(),
+		},
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+
+	domainName := d.Get(names.AttrDomainName).(string)
+	_, err := conn.CreateDomain(ctx, &cloudsearch.CreateDomainInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudSearch Domain (%s): %s", domainName, err)
+	}
+
+	d.SetId(domainName)
+
+	if diags := resourceDomainUpdateIndexFields(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceDomainRead(ctx, d, meta)...)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+
+	out, err := conn.DescribeIndexFields(ctx, &cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s): %s", d.Id(), err)
+	}
+
+	fields, err := FlattenIndexFieldStatuses(out.IndexFields)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "flattening CloudSearch Domain (%s) index fields: %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDomainName, d.Id())
+	d.Set("index_field", fields)
+
+	return diags
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("index_field") {
+		if diags := resourceDomainUpdateIndexFields(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceDomainRead(ctx, d, meta)...)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+
+	_, err := conn.DeleteDomain(ctx, &cloudsearch.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudSearch Domain (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceDomainUpdateIndexFields applies each configured index_field via
+// DefineIndexField and, once every field is defined, reconciles the domain
+// per the auto_index_documents block (if enabled).
+func resourceDomainUpdateIndexFields(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+
+	domainName := d.Id()
+	for _, v := range d.Get("index_field").(*schema.Set).List() {
+		field, err := ExpandIndexField(v.(map[string]interface{}))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "expanding CloudSearch Domain (%s) index field: %s", domainName, err)
+		}
+
+		_, err = conn.DefineIndexField(ctx, &cloudsearch.DefineIndexFieldInput{
+			DomainName: aws.String(domainName),
+			IndexField: field,
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "defining CloudSearch Domain (%s) index field: %s", domainName, err)
+		}
+	}
+
+	return append(diags, reconcileIndexDocuments(ctx, d, meta)...)
+}
+
+// resourceDomainCustomizeDiff validates every configured index_field against
+// CloudSearch's per-type capability matrix so invalid combinations (e.g.
+// sort_enabled on a text field) fail the plan instead of the apply.
+//
+// ExpandIndexField already rejects an invalid combination against the raw
+// config, which is the only way to catch it for capabilities that have no
+// field at all on the per-type Options struct (e.g. IntArrayOptions has no
+// SortEnabled). The explicit ValidateIndexField call below is a second,
+// belt-and-suspenders pass over the field ExpandIndexField built, for
+// capabilities that option structs can represent.
+func resourceDomainCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range diff.Get("index_field").(*schema.Set).List() {
+		field, err := ExpandIndexField(v.(map[string]interface{}))
+		if err != nil {
+			return fmt.Errorf("index_field: %w", err)
+		}
+
+		if err := ValidateIndexField(*field); err != nil {
+			return fmt.Errorf("index_field: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ExpandIndexField converts a single index_field block, as read off
+// ResourceData, into the IndexField shape DefineIndexField expects.
+func ExpandIndexField(tfMap map[string]interface{}) (*types.IndexField, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	fieldType := types.IndexFieldType(tfMap[names.AttrType].(string))
+	field := &types.IndexField{
+		IndexFieldName: aws.String(tfMap[names.AttrName].(string)),
+		IndexFieldType: fieldType,
+	}
+
+	defaultValue := tfMap["default_value"].(string)
+	sourceField := tfMap["source_field"].(string)
+	sourceFields := tfMap["source_fields"].(string)
+	analysisScheme := tfMap["analysis_scheme"].(string)
+	facetEnabledBool := tfMap["facet_enabled"].(bool)
+	returnEnabledBool := tfMap["return_enabled"].(bool)
+	searchEnabledBool := tfMap["search_enabled"].(bool)
+	sortEnabledBool := tfMap["sort_enabled"].(bool)
+	highlightEnabledBool := tfMap["highlight_enabled"].(bool)
+
+	// Validate against the raw config before building the per-type Options
+	// struct: several of those structs have no field at all for a disallowed
+	// capability (e.g. IntArrayOptions has no SortEnabled), so an invalid
+	// flag would otherwise be silently dropped instead of rejected.
+	if err := validateIndexFieldCapabilities(fieldType, aws.ToString(field.IndexFieldName), facetEnabledBool, searchEnabledBool, sortEnabledBool, highlightEnabledBool); err != nil {
+		return nil, err
+	}
+
+	facetEnabled := aws.Bool(facetEnabledBool)
+	returnEnabled := aws.Bool(returnEnabledBool)
+	searchEnabled := aws.Bool(searchEnabledBool)
+	sortEnabled := aws.Bool(sortEnabledBool)
+	highlightEnabled := aws.Bool(highlightEnabledBool)
+
+	switch fieldType {
+	case types.IndexFieldTypeDate:
+		field.DateOptions = &types.DateOptions{
+			DefaultValue:  aws.String(defaultValue),
+			SourceField:   aws.String(sourceField),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+			SortEnabled:   sortEnabled,
+		}
+	case types.IndexFieldTypeDateArray:
+		field.DateArrayOptions = &types.DateArrayOptions{
+			DefaultValue:  aws.String(defaultValue),
+			SourceFields:  aws.String(sourceFields),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+		}
+	case types.IndexFieldTypeDouble:
+		v, err := parseFloat(defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		field.DoubleOptions = &types.DoubleOptions{
+			DefaultValue:  v,
+			SourceField:   aws.String(sourceField),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+			SortEnabled:   sortEnabled,
+		}
+	case types.IndexFieldTypeDoubleArray:
+		v, err := parseFloat(defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		field.DoubleArrayOptions = &types.DoubleArrayOptions{
+			DefaultValue:  v,
+			SourceFields:  aws.String(sourceFields),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+		}
+	case types.IndexFieldTypeInt:
+		v, err := parseInt(defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		field.IntOptions = &types.IntOptions{
+			DefaultValue:  v,
+			SourceField:   aws.String(sourceField),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+			SortEnabled:   sortEnabled,
+		}
+	case types.IndexFieldTypeIntArray:
+		v, err := parseInt(defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		field.IntArrayOptions = &types.IntArrayOptions{
+			DefaultValue:  v,
+			SourceFields:  aws.String(sourceFields),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+		}
+	case types.IndexFieldTypeLatlon:
+		field.LatLonOptions = &types.LatLonOptions{
+			DefaultValue:  aws.String(defaultValue),
+			SourceField:   aws.String(sourceField),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+			SortEnabled:   sortEnabled,
+		}
+	case types.IndexFieldTypeLiteral:
+		field.LiteralOptions = &types.LiteralOptions{
+			DefaultValue:  aws.String(defaultValue),
+			SourceField:   aws.String(sourceField),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+			SortEnabled:   sortEnabled,
+		}
+	case types.IndexFieldTypeLiteralArray:
+		field.LiteralArrayOptions = &types.LiteralArrayOptions{
+			DefaultValue:  aws.String(defaultValue),
+			SourceFields:  aws.String(sourceFields),
+			FacetEnabled:  facetEnabled,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: searchEnabled,
+		}
+	case types.IndexFieldTypeText:
+		field.TextOptions = &types.TextOptions{
+			DefaultValue:     aws.String(defaultValue),
+			SourceField:      aws.String(sourceField),
+			AnalysisScheme:   aws.String(analysisScheme),
+			ReturnEnabled:    returnEnabled,
+			HighlightEnabled: highlightEnabled,
+		}
+	case types.IndexFieldTypeTextArray:
+		field.TextArrayOptions = &types.TextArrayOptions{
+			DefaultValue:     aws.String(defaultValue),
+			SourceFields:     aws.String(sourceFields),
+			AnalysisScheme:   aws.String(analysisScheme),
+			ReturnEnabled:    returnEnabled,
+			HighlightEnabled: highlightEnabled,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported index field type: %s", fieldType)
+	}
+
+	return field, nil
+}
+
+func parseInt(s string) (*int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing default_value %q as int: %w", s, err)
+	}
+	return aws.Int64(v), nil
+}
+
+func parseFloat(s string) (*float64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing default_value %q as double: %w", s, err)
+	}
+	return aws.Float64(v), nil
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// baseIndexFieldTfMap returns a minimal, all-false/empty index_field block
+// for fieldType, with overrides layered on top.
+func baseIndexFieldTfMap(fieldType string, overrides map[string]interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrName:      "test_field",
+		names.AttrType:      fieldType,
+		"default_value":     "",
+		"source_field":      "",
+		"source_fields":     "",
+		"analysis_scheme":   "",
+		"facet_enabled":     false,
+		"return_enabled":    false,
+		"search_enabled":    false,
+		"sort_enabled":      false,
+		"highlight_enabled": false,
+	}
+	for k, v := range overrides {
+		tfMap[k] = v
+	}
+	return tfMap
+}
+
+func TestExpandFlattenIndexField_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		tfMap     map[string]interface{}
+		wantValid bool
+	}{
+		{"date", baseIndexFieldTfMap("date", map[string]interface{}{"sort_enabled": true, "facet_enabled": true, "search_enabled": true, "return_enabled": true}), true},
+		{"date-array", baseIndexFieldTfMap("date-array", map[string]interface{}{"facet_enabled": true, "search_enabled": true, "return_enabled": true}), true},
+		{"date-array cannot be sorted", baseIndexFieldTfMap("date-array", map[string]interface{}{"sort_enabled": true}), false},
+		{"double", baseIndexFieldTfMap("double", map[string]interface{}{"default_value": "1.5", "sort_enabled": true}), true},
+		{"double-array", baseIndexFieldTfMap("double-array", map[string]interface{}{"default_value": "1.5"}), true},
+		{"int", baseIndexFieldTfMap("int", map[string]interface{}{"default_value": "42", "sort_enabled": true}), true},
+		{"int-array", baseIndexFieldTfMap("int-array", map[string]interface{}{"default_value": "42"}), true},
+		{"int-array cannot be sorted", baseIndexFieldTfMap("int-array", map[string]interface{}{"sort_enabled": true}), false},
+		{"latlon", baseIndexFieldTfMap("latlon", map[string]interface{}{"default_value": "1.1,2.2", "sort_enabled": true}), true},
+		{"literal", baseIndexFieldTfMap("literal", map[string]interface{}{"facet_enabled": true, "sort_enabled": true}), true},
+		{"literal-array", baseIndexFieldTfMap("literal-array", map[string]interface{}{"facet_enabled": true, "search_enabled": true}), true},
+		{"literal-array cannot be sorted", baseIndexFieldTfMap("literal-array", map[string]interface{}{"sort_enabled": true}), false},
+		{"text", baseIndexFieldTfMap("text", map[string]interface{}{"return_enabled": true, "highlight_enabled": true}), true},
+		{"text cannot be faceted", baseIndexFieldTfMap("text", map[string]interface{}{"facet_enabled": true}), false},
+		{"text cannot be sorted", baseIndexFieldTfMap("text", map[string]interface{}{"sort_enabled": true}), false},
+		{"text cannot be searched", baseIndexFieldTfMap("text", map[string]interface{}{"search_enabled": true}), false},
+		{"text-array", baseIndexFieldTfMap("text-array", map[string]interface{}{"highlight_enabled": true}), true},
+		{"text-array cannot be faceted", baseIndexFieldTfMap("text-array", map[string]interface{}{"facet_enabled": true}), false},
+		{"text-array cannot be searched", baseIndexFieldTfMap("text-array", map[string]interface{}{"search_enabled": true}), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			field1, err := ExpandIndexField(tc.tfMap)
+			if !tc.wantValid {
+				if err == nil {
+					t.Fatal("expected ExpandIndexField to reject an invalid capability combination, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ExpandIndexField: %s", err)
+			}
+
+			if err := ValidateIndexField(*field1); err != nil {
+				t.Fatalf("expected field to be valid, got: %s", err)
+			}
+
+			// The state Flatten produces from what we just Expanded must agree
+			// with the config that was submitted: a capability the type doesn't
+			// support must already be false in tc.tfMap, not silently dropped.
+			opts1 := flattenIndexFieldOptions(field1)
+			for _, key := range []string{"default_value", "source_field", "source_fields", "analysis_scheme", "facet_enabled", "return_enabled", "search_enabled", "sort_enabled", "highlight_enabled"} {
+				if !reflect.DeepEqual(opts1[key], tc.tfMap[key]) {
+					t.Errorf("config-in vs state-out mismatch for %q: config=%#v state=%#v", key, tc.tfMap[key], opts1[key])
+				}
+			}
+
+			// Round trip: Expand -> Flatten -> Expand must agree with itself.
+			flat := flattenIndexFieldOptions(field1)
+			flat[names.AttrName] = aws.ToString(field1.IndexFieldName)
+			flat[names.AttrType] = string(field1.IndexFieldType)
+
+			field2, err := ExpandIndexField(flat)
+			if err != nil {
+				t.Fatalf("ExpandIndexField (round trip): %s", err)
+			}
+
+			opts2 := flattenIndexFieldOptions(field2)
+			if !reflect.DeepEqual(opts1, opts2) {
+				t.Errorf("round trip mismatch:\nfirst:  %#v\nsecond: %#v", opts1, opts2)
+			}
+		})
+	}
+}
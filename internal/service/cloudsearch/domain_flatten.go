@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// FlattenIndexFieldStatuses converts the index fields returned by
+// DescribeIndexFields into the []map[string]interface{} shape expected by
+// the aws_cloudsearch_domain index_field TypeSet. Fields pending deletion
+// are skipped so that a domain mid-deletion doesn't flap the field count on
+// every refresh.
+func FlattenIndexFieldStatuses(statuses []types.IndexFieldStatus) ([]map[string]interface{}, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]map[string]interface{}, 0, len(statuses))
+	for _, status := range statuses {
+		field, err := FlattenIndexFieldStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		if field == nil {
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// FlattenIndexFieldStatus converts a single IndexFieldStatus into its
+// Terraform representation, or returns nil if the field is pending deletion
+// or otherwise missing the data required to render it.
+func FlattenIndexFieldStatus(status types.IndexFieldStatus) (map[string]interface{}, error) {
+	if status.Options == nil || status.Status == nil {
+		return nil, nil
+	}
+	if aws.ToBool(status.Status.PendingDeletion) {
+		return nil, nil
+	}
+
+	field := status.Options
+	m := map[string]interface{}{
+		names.AttrName: aws.ToString(field.IndexFieldName),
+		names.AttrType: string(field.IndexFieldType),
+	}
+	for k, v := range flattenIndexFieldOptions(field) {
+		m[k] = v
+	}
+
+	return m, nil
+}
+
+// indexFieldCapabilities is the set of per-field capability flags and
+// metadata that every IndexFieldType variant exposes some subset of. It is
+// the common shape flattenIndexFieldOptions fills in from the per-type
+// Options struct before rendering it as the flattened map.
+type indexFieldCapabilities struct {
+	defaultValue     string
+	sourceField      string
+	sourceFields     string
+	analysisScheme   string
+	facetEnabled     bool
+	returnEnabled    bool
+	searchEnabled    bool
+	sortEnabled      bool
+	highlightEnabled bool
+}
+
+func flattenIndexFieldOptions(field *types.IndexField) map[string]interface{} {
+	c := indexFieldCapabilities{}
+
+	switch field.IndexFieldType {
+	case types.IndexFieldTypeDate:
+		if o := field.DateOptions; o != nil {
+			c.defaultValue = aws.ToString(o.DefaultValue)
+			c.sourceField = aws.ToString(o.SourceField)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+			c.sortEnabled = aws.ToBool(o.SortEnabled)
+		}
+	case types.IndexFieldTypeDateArray:
+		if o := field.DateArrayOptions; o != nil {
+			c.defaultValue = aws.ToString(o.DefaultValue)
+			c.sourceFields = aws.ToString(o.SourceFields)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+		}
+	case types.IndexFieldTypeDouble:
+		if o := field.DoubleOptions; o != nil {
+			c.defaultValue = formatFloat(o.DefaultValue)
+			c.sourceField = aws.ToString(o.SourceField)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+			c.sortEnabled = aws.ToBool(o.SortEnabled)
+		}
+	case types.IndexFieldTypeDoubleArray:
+		if o := field.DoubleArrayOptions; o != nil {
+			c.defaultValue = formatFloat(o.DefaultValue)
+			c.sourceFields = aws.ToString(o.SourceFields)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+		}
+	case types.IndexFieldTypeInt:
+		if o := field.IntOptions; o != nil {
+			c.defaultValue = formatInt(o.DefaultValue)
+			c.sourceField = aws.ToString(o.SourceField)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+			c.sortEnabled = aws.ToBool(o.SortEnabled)
+		}
+	case types.IndexFieldTypeIntArray:
+		if o := field.IntArrayOptions; o != nil {
+			c.defaultValue = formatInt(o.DefaultValue)
+			c.sourceFields = aws.ToString(o.SourceFields)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+		}
+	case types.IndexFieldTypeLatlon:
+		if o := field.LatLonOptions; o != nil {
+			c.defaultValue = aws.ToString(o.DefaultValue)
+			c.sourceField = aws.ToString(o.SourceField)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+			c.sortEnabled = aws.ToBool(o.SortEnabled)
+		}
+	case types.IndexFieldTypeLiteral:
+		if o := field.LiteralOptions; o != nil {
+			c.defaultValue = aws.ToString(o.DefaultValue)
+			c.sourceField = aws.ToString(o.SourceField)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+			c.sortEnabled = aws.ToBool(o.SortEnabled)
+		}
+	case types.IndexFieldTypeLiteralArray:
+		if o := field.LiteralArrayOptions; o != nil {
+			c.defaultValue = aws.ToString(o.DefaultValue)
+			c.sourceFields = aws.ToString(o.SourceFields)
+			c.facetEnabled = aws.ToBool(o.FacetEnabled)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.searchEnabled = aws.ToBool(o.SearchEnabled)
+		}
+	case types.IndexFieldTypeText:
+		if o := field.TextOptions; o != nil {
+			c.defaultValue = aws.ToString(o.DefaultValue)
+			c.sourceField = aws.ToString(o.SourceField)
+			c.analysisScheme = aws.ToString(o.AnalysisScheme)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.highlightEnabled = aws.ToBool(o.HighlightEnabled)
+		}
+	case types.IndexFieldTypeTextArray:
+		if o := field.TextArrayOptions; o != nil {
+			c.defaultValue = aws.ToString(o.DefaultValue)
+			c.sourceFields = aws.ToString(o.SourceFields)
+			c.analysisScheme = aws.ToString(o.AnalysisScheme)
+			c.returnEnabled = aws.ToBool(o.ReturnEnabled)
+			c.highlightEnabled = aws.ToBool(o.HighlightEnabled)
+		}
+	}
+
+	return map[string]interface{}{
+		"default_value":     c.defaultValue,
+		"source_field":      c.sourceField,
+		"source_fields":     c.sourceFields,
+		"analysis_scheme":   c.analysisScheme,
+		"facet_enabled":     c.facetEnabled,
+		"return_enabled":    c.returnEnabled,
+		"search_enabled":    c.searchEnabled,
+		"sort_enabled":      c.sortEnabled,
+		"highlight_enabled": c.highlightEnabled,
+	}
+}
+
+func formatInt(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+func formatFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
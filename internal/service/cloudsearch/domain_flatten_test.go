@@ -187,6 +187,65 @@ func TestFlattenIndexFieldStatuses_PendingDeletion(t *testing.T) {
 			expected: 0,
 			wantErr:  false,
 		},
+		{
+			name: "requires index documents field is not skipped",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{
+						IndexFieldName: aws.String("field1"),
+						IndexFieldType: types.IndexFieldTypeLiteral,
+						LiteralOptions: &types.LiteralOptions{
+							ReturnEnabled: aws.Bool(true),
+						},
+					},
+					Status: &types.OptionStatus{
+						CreationDate:    aws.Time(testTime()),
+						State:           types.OptionStateRequiresIndexDocuments,
+						UpdateDate:      aws.Time(testTime()),
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: 1, // RequiresIndexDocuments is still surfaced, only PendingDeletion is filtered
+			wantErr:  false,
+		},
+		{
+			name: "requires index documents and pending deletion together",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{
+						IndexFieldName: aws.String("field1"),
+						IndexFieldType: types.IndexFieldTypeLiteral,
+						LiteralOptions: &types.LiteralOptions{
+							ReturnEnabled: aws.Bool(true),
+						},
+					},
+					Status: &types.OptionStatus{
+						CreationDate:    aws.Time(testTime()),
+						State:           types.OptionStateRequiresIndexDocuments,
+						UpdateDate:      aws.Time(testTime()),
+						PendingDeletion: aws.Bool(true),
+					},
+				},
+				{
+					Options: &types.IndexField{
+						IndexFieldName: aws.String("field2"),
+						IndexFieldType: types.IndexFieldTypeLiteral,
+						LiteralOptions: &types.LiteralOptions{
+							ReturnEnabled: aws.Bool(true),
+						},
+					},
+					Status: &types.OptionStatus{
+						CreationDate:    aws.Time(testTime()),
+						State:           types.OptionStateRequiresIndexDocuments,
+						UpdateDate:      aws.Time(testTime()),
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: 1, // field1 skipped for PendingDeletion, field2 kept despite RequiresIndexDocuments
+			wantErr:  false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// autoIndexDocumentsSchema is merged into ResourceDomain's Schema. It is
+// opt-in: when index_field changes leave fields in RequiresIndexDocuments,
+// the operator must either enable this block or run IndexDocuments
+// themselves.
+func autoIndexDocumentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"initial_interval": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "5s",
+				},
+				"max_interval": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "60s",
+				},
+				"multiplier": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  1.5,
+				},
+				"max_elapsed_time": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "15m",
+				},
+				"randomization_factor": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  0.5,
+				},
+			},
+		},
+	}
+}
+
+type backoffConfig struct {
+	initialInterval     time.Duration
+	maxInterval         time.Duration
+	multiplier          float64
+	maxElapsedTime      time.Duration
+	randomizationFactor float64
+}
+
+func expandAutoIndexDocuments(tfList []interface{}) (bool, backoffConfig, error) {
+	cfg := backoffConfig{
+		initialInterval:     5 * time.Second,
+		maxInterval:         60 * time.Second,
+		multiplier:          1.5,
+		maxElapsedTime:      15 * time.Minute,
+		randomizationFactor: 0.5,
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return false, cfg, nil
+	}
+	tfMap := tfList[0].(map[string]interface{})
+
+	enabled := tfMap["enabled"].(bool)
+	if !enabled {
+		return false, cfg, nil
+	}
+
+	var err error
+	if cfg.initialInterval, err = time.ParseDuration(tfMap["initial_interval"].(string)); err != nil {
+		return false, cfg, err
+	}
+	if cfg.maxInterval, err = time.ParseDuration(tfMap["max_interval"].(string)); err != nil {
+		return false, cfg, err
+	}
+	if cfg.maxElapsedTime, err = time.ParseDuration(tfMap["max_elapsed_time"].(string)); err != nil {
+		return false, cfg, err
+	}
+	cfg.multiplier = tfMap["multiplier"].(float64)
+	cfg.randomizationFactor = tfMap["randomization_factor"].(float64)
+
+	return true, cfg, nil
+}
+
+// nextBackoff computes the sleep duration for the given attempt (0-indexed):
+// min(maxInterval, initialInterval * multiplier^attempt) jittered by
+// ±randomizationFactor.
+func nextBackoff(cfg backoffConfig, attempt int) time.Duration {
+	interval := float64(cfg.initialInterval) * math.Pow(cfg.multiplier, float64(attempt))
+	if max := float64(cfg.maxInterval); interval > max {
+		interval = max
+	}
+
+	delta := cfg.randomizationFactor * interval
+	jittered := interval + delta*(2*rand.Float64()-1)
+	if jittered < 0 {
+		jittered = 0
+	}
+
+	return time.Duration(jittered)
+}
+
+// indexFieldsClient is the subset of the CloudSearch client that
+// reconcileIndexDocuments depends on, narrowed so tests can drive it with a
+// fake and assert on call counts.
+type indexFieldsClient interface {
+	DescribeIndexFields(ctx context.Context, params *cloudsearch.DescribeIndexFieldsInput, optFns ...func(*cloudsearch.Options)) (*cloudsearch.DescribeIndexFieldsOutput, error)
+	IndexDocuments(ctx context.Context, params *cloudsearch.IndexDocumentsInput, optFns ...func(*cloudsearch.Options)) (*cloudsearch.IndexDocumentsOutput, error)
+}
+
+// reconcileIndexDocuments polls DescribeIndexFields and, when any
+// non-pending-deletion field is RequiresIndexDocuments, calls IndexDocuments
+// and waits for every field to converge to Active. It is a no-op unless
+// auto_index_documents.enabled is set.
+func reconcileIndexDocuments(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enabled, cfg, err := expandAutoIndexDocuments(d.Get("auto_index_documents").([]interface{}))
+	if err != nil {
+		var diags diag.Diagnostics
+		return sdkdiag.AppendErrorf(diags, "parsing CloudSearch Domain (%s) auto_index_documents: %s", d.Id(), err)
+	}
+	if !enabled {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+	return reconcileIndexDocumentsWithClient(ctx, conn, d.Id(), cfg)
+}
+
+// reconcileIndexDocumentsWithClient is the testable core of
+// reconcileIndexDocuments: it takes the CloudSearch client as an interface
+// so tests can substitute a fake and count IndexDocuments calls.
+func reconcileIndexDocumentsWithClient(ctx context.Context, conn indexFieldsClient, domainName string, cfg backoffConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statuses, err := describeIndexFieldStatuses(ctx, conn, domainName)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "describing CloudSearch Domain (%s) index fields: %s", domainName, err)
+	}
+	if len(requiresIndexDocumentsFields(statuses)) == 0 {
+		return diags
+	}
+
+	if _, err := conn.IndexDocuments(ctx, &cloudsearch.IndexDocumentsInput{
+		DomainName: aws.String(domainName),
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting CloudSearch Domain (%s) IndexDocuments: %s", domainName, err)
+	}
+
+	pending := pendingIndexDocumentFields(statuses)
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		if len(pending) == 0 {
+			return diags
+		}
+		if time.Since(start) > cfg.maxElapsedTime {
+			return sdkdiag.AppendErrorf(diags, "CloudSearch Domain (%s) did not finish indexing within %s, still pending: %v", domainName, cfg.maxElapsedTime, pending)
+		}
+
+		select {
+		case <-ctx.Done():
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudSearch Domain (%s) to finish indexing: %s", domainName, ctx.Err())
+		case <-time.After(nextBackoff(cfg, attempt)):
+		}
+
+		statuses, err = describeIndexFieldStatuses(ctx, conn, domainName)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "describing CloudSearch Domain (%s) index fields: %s", domainName, err)
+		}
+		pending = pendingIndexDocumentFields(statuses)
+	}
+}
+
+// describeIndexFieldStatuses returns the current index field statuses for
+// domainName.
+func describeIndexFieldStatuses(ctx context.Context, conn indexFieldsClient, domainName string) ([]types.IndexFieldStatus, error) {
+	out, err := conn.DescribeIndexFields(ctx, &cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.IndexFields, nil
+}
+
+// requiresIndexDocumentsFields returns the names of index fields whose State
+// is specifically RequiresIndexDocuments and not PendingDeletion. This is
+// the trigger the reconciler uses to decide whether to call IndexDocuments
+// at all: a field merely Processing already has indexing in flight, and
+// re-triggering on it would start a redundant IndexDocuments call.
+func requiresIndexDocumentsFields(statuses []types.IndexFieldStatus) []string {
+	var fields []string
+	for _, status := range statuses {
+		if status.Options == nil || status.Status == nil {
+			continue
+		}
+		if aws.ToBool(status.Status.PendingDeletion) {
+			continue
+		}
+		if status.Status.State == types.OptionStateRequiresIndexDocuments {
+			fields = append(fields, aws.ToString(status.Options.IndexFieldName))
+		}
+	}
+
+	return fields
+}
+
+// pendingIndexDocumentFields returns the names of index fields that are not
+// yet Active and not PendingDeletion; this is what the reconciler waits to
+// see drop to zero before it stops polling. A field in Processing still has
+// indexing in flight, so it must be treated as pending just like
+// RequiresIndexDocuments.
+func pendingIndexDocumentFields(statuses []types.IndexFieldStatus) []string {
+	var pending []string
+	for _, status := range statuses {
+		if status.Options == nil || status.Status == nil {
+			continue
+		}
+		if aws.ToBool(status.Status.PendingDeletion) {
+			continue
+		}
+		if status.Status.State != types.OptionStateActive {
+			pending = append(pending, aws.ToString(status.Options.IndexFieldName))
+		}
+	}
+
+	return pending
+}
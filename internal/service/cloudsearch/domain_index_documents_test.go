@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+)
+
+func TestPendingIndexDocumentFields(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    []types.IndexFieldStatus
+		expected []string
+	}{
+		{
+			name: "requires index documents field is pending",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateRequiresIndexDocuments,
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: []string{"field1"},
+		},
+		{
+			name: "pending deletion only is never pending",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateRequiresIndexDocuments,
+						PendingDeletion: aws.Bool(true),
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "processing field is still pending",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateProcessing,
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: []string{"field1"},
+		},
+		{
+			name: "active fields are never pending",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateActive,
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := pendingIndexDocumentFields(tc.input)
+			if len(got) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+			for i := range got {
+				if got[i] != tc.expected[i] {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRequiresIndexDocumentsFields(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    []types.IndexFieldStatus
+		expected []string
+	}{
+		{
+			name: "requires index documents field triggers reconciliation",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateRequiresIndexDocuments,
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: []string{"field1"},
+		},
+		{
+			name: "pending deletion only never triggers reconciliation",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateRequiresIndexDocuments,
+						PendingDeletion: aws.Bool(true),
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "processing field does not re-trigger reconciliation",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateProcessing,
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "active fields never trigger reconciliation",
+			input: []types.IndexFieldStatus{
+				{
+					Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+					Status: &types.OptionStatus{
+						State:           types.OptionStateActive,
+						PendingDeletion: aws.Bool(false),
+					},
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := requiresIndexDocumentsFields(tc.input)
+			if len(got) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+			for i := range got {
+				if got[i] != tc.expected[i] {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+				}
+			}
+		})
+	}
+}
+
+// fakeIndexFieldsClient is a hand-rolled fake of indexFieldsClient: each
+// call to DescribeIndexFields returns the next entry in statusSequence
+// (repeating the last entry once exhausted), and indexDocumentsCalls counts
+// invocations of IndexDocuments.
+type fakeIndexFieldsClient struct {
+	statusSequence      [][]types.IndexFieldStatus
+	describeCalls       int
+	indexDocumentsCalls int
+}
+
+func (f *fakeIndexFieldsClient) DescribeIndexFields(ctx context.Context, params *cloudsearch.DescribeIndexFieldsInput, optFns ...func(*cloudsearch.Options)) (*cloudsearch.DescribeIndexFieldsOutput, error) {
+	i := f.describeCalls
+	if i >= len(f.statusSequence) {
+		i = len(f.statusSequence) - 1
+	}
+	f.describeCalls++
+
+	return &cloudsearch.DescribeIndexFieldsOutput{IndexFields: f.statusSequence[i]}, nil
+}
+
+func (f *fakeIndexFieldsClient) IndexDocuments(ctx context.Context, params *cloudsearch.IndexDocumentsInput, optFns ...func(*cloudsearch.Options)) (*cloudsearch.IndexDocumentsOutput, error) {
+	f.indexDocumentsCalls++
+	return &cloudsearch.IndexDocumentsOutput{}, nil
+}
+
+func fieldStatus(name string, state types.OptionState) types.IndexFieldStatus {
+	return types.IndexFieldStatus{
+		Options: &types.IndexField{IndexFieldName: aws.String(name)},
+		Status: &types.OptionStatus{
+			State:           state,
+			PendingDeletion: aws.Bool(false),
+		},
+	}
+}
+
+func TestReconcileIndexDocumentsWithClient(t *testing.T) {
+	t.Parallel()
+
+	cfg := backoffConfig{
+		initialInterval: time.Millisecond,
+		maxInterval:     time.Millisecond,
+		multiplier:      1,
+		maxElapsedTime:  time.Second,
+	}
+
+	t.Run("triggers IndexDocuments exactly once and waits for convergence", func(t *testing.T) {
+		t.Parallel()
+
+		conn := &fakeIndexFieldsClient{
+			statusSequence: [][]types.IndexFieldStatus{
+				{fieldStatus("field1", types.OptionStateRequiresIndexDocuments)},
+				{fieldStatus("field1", types.OptionStateProcessing)},
+				{fieldStatus("field1", types.OptionStateActive)},
+			},
+		}
+
+		if diags := reconcileIndexDocumentsWithClient(context.Background(), conn, "test-domain", cfg); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if conn.indexDocumentsCalls != 1 {
+			t.Errorf("expected exactly 1 IndexDocuments call, got %d", conn.indexDocumentsCalls)
+		}
+	})
+
+	t.Run("pending-deletion-only fields never trigger IndexDocuments", func(t *testing.T) {
+		t.Parallel()
+
+		conn := &fakeIndexFieldsClient{
+			statusSequence: [][]types.IndexFieldStatus{
+				{
+					{
+						Options: &types.IndexField{IndexFieldName: aws.String("field1")},
+						Status: &types.OptionStatus{
+							State:           types.OptionStateRequiresIndexDocuments,
+							PendingDeletion: aws.Bool(true),
+						},
+					},
+				},
+			},
+		}
+
+		if diags := reconcileIndexDocumentsWithClient(context.Background(), conn, "test-domain", cfg); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if conn.indexDocumentsCalls != 0 {
+			t.Errorf("expected 0 IndexDocuments calls, got %d", conn.indexDocumentsCalls)
+		}
+	})
+
+	t.Run("processing-only fields never re-trigger IndexDocuments", func(t *testing.T) {
+		t.Parallel()
+
+		conn := &fakeIndexFieldsClient{
+			statusSequence: [][]types.IndexFieldStatus{
+				{fieldStatus("field1", types.OptionStateProcessing)},
+			},
+		}
+
+		if diags := reconcileIndexDocumentsWithClient(context.Background(), conn, "test-domain", cfg); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if conn.indexDocumentsCalls != 0 {
+			t.Errorf("expected 0 IndexDocuments calls, got %d", conn.indexDocumentsCalls)
+		}
+	})
+}
+
+func TestNextBackoff(t *testing.T) {
+	t.Parallel()
+
+	cfg := backoffConfig{
+		initialInterval:     1 * time.Second,
+		maxInterval:         10 * time.Second,
+		multiplier:          2,
+		randomizationFactor: 0,
+	}
+
+	if got := nextBackoff(cfg, 0); got != 1*time.Second {
+		t.Errorf("attempt 0: expected 1s, got %s", got)
+	}
+	if got := nextBackoff(cfg, 2); got != 4*time.Second {
+		t.Errorf("attempt 2: expected 4s, got %s", got)
+	}
+	if got := nextBackoff(cfg, 10); got != cfg.maxInterval {
+		t.Errorf("attempt 10: expected capped at %s, got %s", cfg.maxInterval, got)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudsearch_domain_schema", name="Domain Schema")
+func DataSourceDomainSchema() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainSchemaRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_pending_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"requires_index_documents": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			// fields models Elasticsearch's field-capabilities response: one
+			// list entry per index field, carrying its name and a one-element
+			// capabilities list whose entry records the field's IndexFieldType
+			// under type alongside the capability flags. Neither level is a
+			// map keyed by field name or by type.
+			"fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"capabilities": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"searchable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"facetable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"returnable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"sortable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"highlightable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"analysis_scheme": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"default_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_field": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_fields": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrState: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"pending_deletion": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"creation_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"update_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+
+	domainName := d.Get(names.AttrDomainName).(string)
+	includePendingDeletion := d.Get("include_pending_deletion").(bool)
+
+	out, err := conn.DescribeIndexFields(ctx, &cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) index fields: %s", domainName, err)
+	}
+
+	fields := make([]interface{}, 0, len(out.IndexFields))
+	requiresIndexDocuments := false
+
+	for _, status := range out.IndexFields {
+		if status.Options == nil || status.Status == nil {
+			continue
+		}
+		if aws.ToBool(status.Status.PendingDeletion) && !includePendingDeletion {
+			continue
+		}
+		if status.Status.State == types.OptionStateRequiresIndexDocuments {
+			requiresIndexDocuments = true
+		}
+
+		fields = append(fields, map[string]interface{}{
+			names.AttrName: aws.ToString(status.Options.IndexFieldName),
+			"capabilities": []interface{}{flattenFieldCapabilities(status)},
+		})
+	}
+
+	d.SetId(domainName)
+	d.Set("fields", fields)
+	d.Set("requires_index_documents", requiresIndexDocuments)
+
+	return diags
+}
+
+// flattenFieldCapabilities models a single index field's capabilities after
+// Elasticsearch's field-capabilities response, scoped to the field's current
+// IndexFieldType, plus its OptionStatus (state, dates, pending_deletion).
+func flattenFieldCapabilities(status types.IndexFieldStatus) map[string]interface{} {
+	field := status.Options
+	opts := flattenIndexFieldOptions(field)
+
+	capabilities := map[string]interface{}{
+		names.AttrType:     string(field.IndexFieldType),
+		"searchable":       opts["search_enabled"].(bool),
+		"facetable":        opts["facet_enabled"].(bool),
+		"returnable":       opts["return_enabled"].(bool),
+		"sortable":         opts["sort_enabled"].(bool),
+		"highlightable":    opts["highlight_enabled"].(bool),
+		"analysis_scheme":  opts["analysis_scheme"].(string),
+		"default_value":    opts["default_value"].(string),
+		"source_field":     opts["source_field"].(string),
+		"source_fields":    opts["source_fields"].(string),
+		names.AttrState:    string(status.Status.State),
+		"pending_deletion": aws.ToBool(status.Status.PendingDeletion),
+	}
+	if status.Status.CreationDate != nil {
+		capabilities["creation_date"] = status.Status.CreationDate.String()
+	}
+	if status.Status.UpdateDate != nil {
+		capabilities["update_date"] = status.Status.UpdateDate.String()
+	}
+
+	return capabilities
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+)
+
+// indexFieldTypeCapabilities records which option flags CloudSearch accepts
+// for a given IndexFieldType. Array types can never be sorted (there is no
+// well-defined sort order for a multi-valued field). Text types can be
+// highlighted but never faceted or sorted; they also have no SearchEnabled
+// option at all in the CloudSearch API (text fields are always searched),
+// so search_enabled is rejected rather than silently dropped.
+type indexFieldTypeCapabilities struct {
+	facetAllowed     bool
+	searchAllowed    bool
+	sortAllowed      bool
+	highlightAllowed bool
+}
+
+var indexFieldTypeCapabilityMatrix = map[types.IndexFieldType]indexFieldTypeCapabilities{
+	types.IndexFieldTypeDate:         {facetAllowed: true, searchAllowed: true, sortAllowed: true},
+	types.IndexFieldTypeDateArray:    {facetAllowed: true, searchAllowed: true, sortAllowed: false},
+	types.IndexFieldTypeDouble:       {facetAllowed: true, searchAllowed: true, sortAllowed: true},
+	types.IndexFieldTypeDoubleArray:  {facetAllowed: true, searchAllowed: true, sortAllowed: false},
+	types.IndexFieldTypeInt:          {facetAllowed: true, searchAllowed: true, sortAllowed: true},
+	types.IndexFieldTypeIntArray:     {facetAllowed: true, searchAllowed: true, sortAllowed: false},
+	types.IndexFieldTypeLatlon:       {facetAllowed: true, searchAllowed: true, sortAllowed: true},
+	types.IndexFieldTypeLiteral:      {facetAllowed: true, searchAllowed: true, sortAllowed: true},
+	types.IndexFieldTypeLiteralArray: {facetAllowed: true, searchAllowed: true, sortAllowed: false},
+	types.IndexFieldTypeText:         {facetAllowed: false, searchAllowed: false, sortAllowed: false, highlightAllowed: true},
+	types.IndexFieldTypeTextArray:    {facetAllowed: false, searchAllowed: false, sortAllowed: false, highlightAllowed: true},
+}
+
+// ValidateIndexField encodes CloudSearch's per-type capability matrix so
+// that invalid combinations (e.g. SortEnabled on a text-array field) surface
+// as a plan-time error instead of an opaque API error at apply time.
+//
+// Note this only catches combinations that survive into the IndexField
+// struct itself; several per-type Options structs (e.g. IntArrayOptions)
+// have no field at all for a disallowed capability, so that case is instead
+// rejected earlier, in ExpandIndexField, against the raw config.
+func ValidateIndexField(field types.IndexField) error {
+	name := aws.ToString(field.IndexFieldName)
+	opts := flattenIndexFieldOptions(&field)
+
+	return validateIndexFieldCapabilities(field.IndexFieldType, name, opts["facet_enabled"].(bool), opts["search_enabled"].(bool), opts["sort_enabled"].(bool), opts["highlight_enabled"].(bool))
+}
+
+// validateIndexFieldCapabilities is the shared capability check behind
+// ValidateIndexField and ExpandIndexField's config-time validation.
+func validateIndexFieldCapabilities(fieldType types.IndexFieldType, name string, facetEnabled, searchEnabled, sortEnabled, highlightEnabled bool) error {
+	caps, ok := indexFieldTypeCapabilityMatrix[fieldType]
+	if !ok {
+		return fmt.Errorf("unsupported index field type: %s", fieldType)
+	}
+
+	if facetEnabled && !caps.facetAllowed {
+		return fmt.Errorf("index field %q: facet_enabled is not supported for type %s", name, fieldType)
+	}
+	if searchEnabled && !caps.searchAllowed {
+		return fmt.Errorf("index field %q: search_enabled is not supported for type %s", name, fieldType)
+	}
+	if sortEnabled && !caps.sortAllowed {
+		return fmt.Errorf("index field %q: sort_enabled is not supported for type %s", name, fieldType)
+	}
+	if highlightEnabled && !caps.highlightAllowed {
+		return fmt.Errorf("index field %q: highlight_enabled is not supported for type %s", name, fieldType)
+	}
+
+	return nil
+}